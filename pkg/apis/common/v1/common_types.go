@@ -0,0 +1,46 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License 2.0;
+// you may not use this file except in compliance with the Elastic License 2.0.
+
+// Package v1 holds the types shared across the different Elastic resource APIs (Elasticsearch,
+// Kibana, ...), such as Secret references and Service/object metadata templates.
+package v1
+
+// SecretRef is a reference to a Secret in the same namespace as the resource it is used from.
+type SecretRef struct {
+	// SecretName is the name of the Secret.
+	SecretName string `json:"secretName,omitempty"`
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretRef) DeepCopyInto(out *SecretRef) {
+	*out = *in
+}
+
+// Metadata allows users to add labels and annotations to the child resources (Services,
+// ConfigMaps, ...) generated on their behalf, merged with the ones the operator sets itself.
+type Metadata struct {
+	// Labels are appended to the labels the operator sets on the resource.
+	// +kubebuilder:validation:Optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations are appended to the annotations the operator sets on the resource.
+	// +kubebuilder:validation:Optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Metadata) DeepCopyInto(out *Metadata) {
+	*out = *in
+	if in.Labels != nil {
+		out.Labels = make(map[string]string, len(in.Labels))
+		for k, v := range in.Labels {
+			out.Labels[k] = v
+		}
+	}
+	if in.Annotations != nil {
+		out.Annotations = make(map[string]string, len(in.Annotations))
+		for k, v := range in.Annotations {
+			out.Annotations[k] = v
+		}
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License 2.0;
+// you may not use this file except in compliance with the Elastic License 2.0.
+
+// Package v1 contains the Elasticsearch v1 API types, the current Elasticsearch CRD version
+// served by the operator (module path github.com/elastic/cloud-on-k8s/v2).
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	commonv1 "github.com/elastic/cloud-on-k8s/v2/pkg/apis/common/v1"
+	"github.com/elastic/cloud-on-k8s/v2/pkg/controller/common/name"
+)
+
+// ESNamer builds the names of the child resources (Services, Secrets, ...) of an Elasticsearch
+// resource, e.g. ESNamer.Suffix("mycluster", "http") -> "mycluster-es-http".
+var ESNamer = name.NewNamer("es")
+
+// Kind is the Kubernetes resource kind for Elasticsearch.
+const Kind = "Elasticsearch"
+
+// GroupVersion is the API group and version Elasticsearch resources are served under.
+var GroupVersion = schema.GroupVersion{Group: "elasticsearch.k8s.elastic.co", Version: "v1"}
+
+// +kubebuilder:object:root=true
+
+// Elasticsearch represents an Elasticsearch resource in a Kubernetes cluster.
+type Elasticsearch struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ElasticsearchSpec   `json:"spec,omitempty"`
+	Status ElasticsearchStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ElasticsearchList contains a list of Elasticsearch resources.
+type ElasticsearchList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Elasticsearch `json:"items"`
+}
+
+// ElasticsearchSpec holds the specification of an Elasticsearch cluster.
+type ElasticsearchSpec struct {
+	// Transport holds the transport layer (inter-node communication) configuration.
+	// +kubebuilder:validation:Optional
+	Transport TransportConfig `json:"transport,omitempty"`
+	// HTTP holds the HTTP layer configuration for the cluster, including the Services exposing it.
+	// +kubebuilder:validation:Optional
+	HTTP HTTPConfig `json:"http,omitempty"`
+}
+
+// ElasticsearchStatus reflects the observed state of an Elasticsearch cluster.
+type ElasticsearchStatus struct {
+	// Phase summarizes the cluster's overall reconciliation state.
+	Phase string `json:"phase,omitempty"`
+}
+
+// TransportConfig holds the transport layer (inter-node communication) configuration.
+type TransportConfig struct {
+	// TLS holds TLS configuration options for the transport layer.
+	// +kubebuilder:validation:Optional
+	TLS TransportTLSOptions `json:"tls,omitempty"`
+}
+
+// TransportTLSOptions holds TLS configuration options for the transport layer.
+type TransportTLSOptions struct {
+	// Certificate is a reference to a Secret containing the CA certificate and private key used to
+	// sign transport certificates, in lieu of the operator-managed self-signed CA.
+	// +kubebuilder:validation:Optional
+	Certificate commonv1.SecretRef `json:"certificate,omitempty"`
+
+	// AdditionalCAConfigMapRef references a ConfigMap containing one or more additional PEM-encoded
+	// CA certificates to add to the transport trust chain, alongside the operator-generated (or
+	// user-provided) CA. This lets Elasticsearch nodes trust external CAs used by sidecars,
+	// cross-cluster remote clients, or mTLS-protected snapshot repositories, without replacing the
+	// operator-managed CA.
+	// +kubebuilder:validation:Optional
+	AdditionalCAConfigMapRef *corev1.LocalObjectReference `json:"additionalCAConfigMapRef,omitempty"`
+}
+
+// HTTPConfig holds the HTTP layer configuration for an Elasticsearch cluster.
+type HTTPConfig struct {
+	// Service defines the Service used to expose Elasticsearch's HTTP endpoint, optionally fronted
+	// by an OAuth-proxy sidecar.
+	// +kubebuilder:validation:Optional
+	Service ServiceConfig `json:"service,omitempty"`
+
+	// Services defines additional, node-role-scoped Services (e.g. coordinating, ingest, ml),
+	// letting users route traffic to a subset of node pools without installing a separate ingress.
+	// +kubebuilder:validation:Optional
+	Services []NamedServiceSpec `json:"services,omitempty"`
+}
+
+// ServiceConfig customizes the Service used to expose Elasticsearch's HTTP endpoint.
+type ServiceConfig struct {
+	// Metadata customizes the Service's labels and annotations.
+	// +kubebuilder:validation:Optional
+	Metadata commonv1.Metadata `json:"metadata,omitempty"`
+	// Spec customizes the Service's ServiceSpec (only a subset of fields, such as Type, are honored).
+	// +kubebuilder:validation:Optional
+	Spec corev1.ServiceSpec `json:"spec,omitempty"`
+	// AuthProxy, when set and enabled, fronts the Service with an OAuth-proxy sidecar authenticating
+	// callers using their ServiceAccount token instead of Elasticsearch credentials.
+	// +kubebuilder:validation:Optional
+	AuthProxy *AuthProxyConfig `json:"authProxy,omitempty"`
+}
+
+// AuthProxyConfig configures the OAuth-proxy sidecar fronting Elasticsearch's HTTP Service.
+type AuthProxyConfig struct {
+	// Enabled turns on the OAuth-proxy sidecar.
+	Enabled bool `json:"enabled,omitempty"`
+	// Image is the OAuth-proxy container image to use.
+	// +kubebuilder:validation:Optional
+	Image string `json:"image,omitempty"`
+}
+
+// NamedServiceSpec defines an additional Service scoped to a single node role.
+type NamedServiceSpec struct {
+	// Role is the node role the Service's selector is narrowed to (e.g. "coordinating", "ingest",
+	// "ml").
+	Role string `json:"role"`
+	// Metadata customizes the Service's labels and annotations.
+	// +kubebuilder:validation:Optional
+	Metadata commonv1.Metadata `json:"metadata,omitempty"`
+	// Spec customizes the Service's ServiceSpec (only a subset of fields, such as Type, are honored).
+	// +kubebuilder:validation:Optional
+	Spec corev1.ServiceSpec `json:"spec,omitempty"`
+}
@@ -0,0 +1,102 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License 2.0;
+// you may not use this file except in compliance with the Elastic License 2.0.
+
+package certificates
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/elastic/cloud-on-k8s/v2/pkg/utils/k8s"
+)
+
+// CertCache watches a Secret holding a tls.crt/tls.key pair and keeps a parsed tls.Certificate
+// in memory, so that operator-internal clients (e.g. the ES client used for reconciliation) pick
+// up certificate rotation without needing to reload the Secret on every request or restart the
+// process. It is meant to back tls.Config.GetCertificate for internal-only TLS connections, such
+// as the cluster-local "internal" Service.
+//
+// The ES client factory should hold one CertCache per cluster (keyed by the internal Secret's
+// namespaced name) and set tls.Config.GetCertificate to its GetCertificate method instead of
+// reading the client certificate Secret on every request.
+type CertCache struct {
+	client k8s.Client
+	secretRef types.NamespacedName
+
+	mu   sync.RWMutex
+	cert *tls.Certificate
+}
+
+// NewCertCache returns a CertCache for the given tls.crt/tls.key Secret. Call Start to begin
+// watching it; GetCertificate can be called as soon as the first reconciliation has populated
+// the cache.
+func NewCertCache(client k8s.Client, secretRef types.NamespacedName) *CertCache {
+	return &CertCache{
+		client:    client,
+		secretRef: secretRef,
+	}
+}
+
+// Start blocks, reloading the cached certificate from the backing Secret once up front and then
+// on every value received from events, until ctx is cancelled (it then returns nil) or a reload
+// fails (it then returns that error and stops reloading). It should be run in its own goroutine.
+func (c *CertCache) Start(ctx context.Context, events <-chan struct{}) error {
+	if err := c.reload(ctx); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-events:
+			if err := c.reload(ctx); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// reload re-reads the Secret and re-parses the tls.crt/tls.key pair into the cache.
+func (c *CertCache) reload(ctx context.Context) error {
+	var secret corev1.Secret
+	if err := c.client.Get(ctx, c.secretRef, &secret); err != nil {
+		return fmt.Errorf("while reloading cert cache from secret %s: %w", c.secretRef, err)
+	}
+
+	certData, ok := secret.Data[CertFileName]
+	if !ok {
+		return fmt.Errorf("secret %s is missing %s", c.secretRef, CertFileName)
+	}
+	keyData, ok := secret.Data[KeyFileName]
+	if !ok {
+		return fmt.Errorf("secret %s is missing %s", c.secretRef, KeyFileName)
+	}
+
+	cert, err := tls.X509KeyPair(certData, keyData)
+	if err != nil {
+		return fmt.Errorf("while parsing tls key pair from secret %s: %w", c.secretRef, err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cert = &cert
+	return nil
+}
+
+// GetCertificate returns the currently cached certificate. It is suitable for use as
+// tls.Config.GetCertificate, so TLS rotation is picked up on the next handshake without requiring
+// a process restart.
+func (c *CertCache) GetCertificate(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.cert == nil {
+		return nil, fmt.Errorf("cert cache for secret %s has not been populated yet", c.secretRef)
+	}
+	return c.cert, nil
+}
@@ -0,0 +1,32 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License 2.0;
+// you may not use this file except in compliance with the Elastic License 2.0.
+
+package certificates
+
+import (
+	"crypto/tls"
+	"testing"
+)
+
+func TestCertCache_GetCertificate(t *testing.T) {
+	t.Run("returns an error before the cache has been populated", func(t *testing.T) {
+		c := &CertCache{}
+		if _, err := c.GetCertificate(nil); err == nil {
+			t.Fatal("expected an error from an unpopulated cache, got nil")
+		}
+	})
+
+	t.Run("returns the cached certificate once populated", func(t *testing.T) {
+		want := &tls.Certificate{}
+		c := &CertCache{cert: want}
+
+		got, err := c.GetCertificate(nil)
+		if err != nil {
+			t.Fatalf("GetCertificate() error = %v", err)
+		}
+		if got != want {
+			t.Fatalf("GetCertificate() = %v, want the cached certificate %v", got, want)
+		}
+	})
+}
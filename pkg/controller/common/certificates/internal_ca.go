@@ -0,0 +1,44 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License 2.0;
+// you may not use this file except in compliance with the Elastic License 2.0.
+
+package certificates
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/elastic/cloud-on-k8s/v2/pkg/utils/k8s"
+)
+
+// InternalCAType identifies the dedicated CA used to sign certificates for cluster-local-only
+// Services (e.g. the "internal" Service), kept separate from the CA trusted by external clients.
+const InternalCAType = CAType("internal")
+
+// defaultInternalCARotationParams is used whenever a caller doesn't specify its own RotationParams
+// (the zero value). It rotates more aggressively than the user-facing CA's default, since the
+// Secret it lives in is never read outside the cluster and can safely be rotated more often.
+var defaultInternalCARotationParams = RotationParams{
+	Validity:     30 * 24 * time.Hour,
+	RotateBefore: 24 * time.Hour,
+}
+
+// ReconcileInternalCAForOwner mirrors ReconcileCAForOwner but issues from, and rotates, a CA
+// dedicated to cluster-internal TLS traffic. When rotationParams is the zero value, it falls back
+// to defaultInternalCARotationParams, a shorter window than the user-facing CA; callers can still
+// pass their own RotationParams to override it.
+func ReconcileInternalCAForOwner(
+	ctx context.Context,
+	client k8s.Client,
+	namer Namer,
+	owner runtime.Object,
+	labels map[string]string,
+	rotationParams RotationParams,
+) (*CA, error) {
+	if rotationParams == (RotationParams{}) {
+		rotationParams = defaultInternalCARotationParams
+	}
+	return ReconcileCAForOwner(ctx, client, namer, owner, labels, InternalCAType, rotationParams)
+}
@@ -0,0 +1,29 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License 2.0;
+// you may not use this file except in compliance with the Elastic License 2.0.
+
+// Package name builds the names of the child Kubernetes resources (Secrets, Services, ...)
+// generated for an Elastic resource, keeping a single, consistent naming scheme across
+// controllers.
+package name
+
+import "strings"
+
+// Namer builds names of the form "<resource-name>-<defaultSuffix>[-<suffix>...]" for the child
+// resources of a given Elastic resource.
+type Namer struct {
+	defaultSuffix string
+}
+
+// NewNamer returns a Namer appending defaultSuffix (e.g. "es") ahead of any additional suffix
+// passed to Suffix.
+func NewNamer(defaultSuffix string) Namer {
+	return Namer{defaultSuffix: defaultSuffix}
+}
+
+// Suffix joins resourceName with the Namer's default suffix and any additional suffixes, e.g.
+// Suffix("mycluster", "http") -> "mycluster-es-http".
+func (n Namer) Suffix(resourceName string, suffixes ...string) string {
+	parts := append([]string{resourceName, n.defaultSuffix}, suffixes...)
+	return strings.Join(parts, "-")
+}
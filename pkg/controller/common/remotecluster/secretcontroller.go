@@ -0,0 +1,318 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License 2.0;
+// you may not use this file except in compliance with the Elastic License 2.0.
+
+// Package remotecluster discovers Elasticsearch clusters running in remote Kubernetes clusters
+// and publishes local Service/Endpoints objects pointing at their transport port, so that
+// cross-cluster replication (CCR) and cross-cluster search (CCS) work across flat-networked
+// Kubernetes clusters without requiring external LoadBalancers.
+//
+// The design mirrors Istio's multi-cluster secret controller: kubeconfig Secrets labeled with
+// RemoteClusterSecretLabel are watched in the local cluster, and for each one a client to the
+// remote cluster is created to watch Elasticsearch resources there.
+package remotecluster
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	esv1 "github.com/elastic/cloud-on-k8s/v2/pkg/apis/elasticsearch/v1"
+	"github.com/elastic/cloud-on-k8s/v2/pkg/controller/elasticsearch/services"
+	"github.com/elastic/cloud-on-k8s/v2/pkg/utils/k8s"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var log = logf.Log.WithName("remotecluster")
+
+const (
+	// RemoteClusterSecretLabel marks a kubeconfig Secret as describing a remote Kubernetes
+	// cluster the operator should discover Elasticsearch resources in.
+	RemoteClusterSecretLabel = "elasticsearch.k8s.elastic.co/remote-cluster"
+
+	transportPort = 9300
+)
+
+// Note: transport certificates for the local cluster must also trust each remote cluster's CA for
+// CCR/CCS TLS handshakes to succeed. Callers are expected to surface the remote CA (read from the
+// same kubeconfig Secret, or a dedicated CA Secret referenced alongside it) through the
+// AdditionalCAConfigMapRef mechanism in transport.ReconcileOrRetrieveCA.
+
+// remoteCluster bundles a remote Kubernetes client with the local cache of what it has published.
+// publishedNSNs is written from the background goroutine started by ReconcileSecret (see
+// watchRemoteElasticsearch/publish) and read/deleted from teardown, which can run concurrently on
+// a Secret update or deletion; mu guards it against a concurrent map read/write.
+type remoteCluster struct {
+	name      string
+	client    k8s.Client
+	stopWatch context.CancelFunc
+
+	mu            sync.Mutex
+	publishedNSNs map[types.NamespacedName]struct{}
+}
+
+// Controller watches kubeconfig Secrets labeled with RemoteClusterSecretLabel in the local
+// cluster, maintains a remote client per Secret, and publishes a headless Service + Endpoints in
+// the local namespace for every remote Elasticsearch cluster it discovers.
+type Controller struct {
+	localClient k8s.Client
+
+	mu      sync.Mutex
+	remotes map[types.NamespacedName]*remoteCluster
+}
+
+// NewController returns a Controller that reconciles against the given local client.
+func NewController(localClient k8s.Client) *Controller {
+	return &Controller{
+		localClient: localClient,
+		remotes:     map[types.NamespacedName]*remoteCluster{},
+	}
+}
+
+// ReconcileSecret is called whenever a Secret labeled with RemoteClusterSecretLabel is added,
+// updated or deleted. It (re)connects to the remote cluster described by the Secret's kubeconfig
+// and starts or stops the watch accordingly.
+func (c *Controller) ReconcileSecret(ctx context.Context, secretNSN types.NamespacedName) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var secret corev1.Secret
+	err := c.localClient.Get(ctx, secretNSN, &secret)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// Secret was deleted: tear down the remote client and anything it published.
+			return c.teardown(secretNSN)
+		}
+		// A transient API server error must not be confused with deletion: propagate it so the
+		// caller retries instead of destroying a healthy remote-cluster connection.
+		return err
+	}
+
+	kubeconfig, ok := secret.Data["kubeconfig"]
+	if !ok {
+		return fmt.Errorf("remote cluster secret %s is missing a kubeconfig key", secretNSN)
+	}
+
+	if existing, ok := c.remotes[secretNSN]; ok {
+		existing.stopWatch()
+		delete(c.remotes, secretNSN)
+	}
+
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return fmt.Errorf("while parsing kubeconfig from secret %s: %w", secretNSN, err)
+	}
+
+	remoteClient, err := k8s.NewClient(restConfig)
+	if err != nil {
+		return fmt.Errorf("while creating client for remote cluster %s: %w", secretNSN, err)
+	}
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	rc := &remoteCluster{
+		name:          secretNSN.Name,
+		client:        remoteClient,
+		stopWatch:     cancel,
+		publishedNSNs: map[types.NamespacedName]struct{}{},
+	}
+	c.remotes[secretNSN] = rc
+
+	go c.watchRemoteElasticsearch(watchCtx, rc)
+	return nil
+}
+
+// watchRemoteElasticsearch lists, then watches, Elasticsearch resources in the remote cluster,
+// publishing a local Service + Endpoints for each one added or updated, until ctx is cancelled (by
+// teardown, or by a subsequent ReconcileSecret replacing this remoteCluster). Using a real watch
+// rather than a poll loop means clusters created in the remote cluster after the initial list are
+// discovered as soon as the remote API server delivers the watch event, and cancellation actually
+// stops the underlying watch instead of being a no-op.
+func (c *Controller) watchRemoteElasticsearch(ctx context.Context, rc *remoteCluster) {
+	var esList esv1.ElasticsearchList
+	if err := rc.client.List(ctx, &esList); err != nil {
+		log.Error(err, "failed to list Elasticsearch resources in remote cluster", "remote_cluster", rc.name)
+		return
+	}
+	for i := range esList.Items {
+		if err := c.publish(ctx, rc, esList.Items[i]); err != nil {
+			log.Error(err, "failed to publish remote Elasticsearch cluster", "remote_cluster", rc.name, "namespace", esList.Items[i].Namespace, "es_name", esList.Items[i].Name)
+		}
+	}
+
+	watcher, err := rc.client.Watch(ctx, &esv1.ElasticsearchList{})
+	if err != nil {
+		log.Error(err, "failed to watch Elasticsearch resources in remote cluster", "remote_cluster", rc.name)
+		return
+	}
+	defer watcher.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return
+			}
+			es, ok := event.Object.(*esv1.Elasticsearch)
+			if !ok {
+				continue
+			}
+			switch event.Type {
+			case watch.Added, watch.Modified:
+				if err := c.publish(ctx, rc, *es); err != nil {
+					log.Error(err, "failed to publish remote Elasticsearch cluster", "remote_cluster", rc.name, "namespace", es.Namespace, "es_name", es.Name)
+				}
+			case watch.Deleted:
+				if err := c.unpublish(ctx, rc, *es); err != nil {
+					log.Error(err, "failed to unpublish removed remote Elasticsearch cluster", "remote_cluster", rc.name, "namespace", es.Namespace, "es_name", es.Name)
+				}
+			}
+		}
+	}
+}
+
+// publish creates or updates the local headless Service and Endpoints pointing at the transport
+// port of a remote Elasticsearch cluster, resolvable locally as
+// "<remote-es>.<ns>.svc.cluster.local".
+func (c *Controller) publish(ctx context.Context, rc *remoteCluster, es esv1.Elasticsearch) error {
+	svcName := services.RemoteTransportServiceName(es.Name)
+	nsn := types.NamespacedName{Namespace: es.Namespace, Name: svcName}
+
+	wantSvc := services.NewRemoteTransportService(es, rc.name)
+	var existingSvc corev1.Service
+	if err := c.localClient.Get(ctx, nsn, &existingSvc); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		if err := c.localClient.Create(ctx, wantSvc); err != nil {
+			return err
+		}
+	} else {
+		// Update the object returned by Get, which carries the ResourceVersion the API server
+		// requires: updating a freshly constructed object would be rejected after the first Create.
+		existingSvc.Labels = wantSvc.Labels
+		existingSvc.Annotations = wantSvc.Annotations
+		existingSvc.Spec = wantSvc.Spec
+		if err := c.localClient.Update(ctx, &existingSvc); err != nil {
+			return err
+		}
+	}
+
+	wantEndpoints, err := remoteEndpoints(ctx, rc.client, es)
+	if err != nil {
+		return err
+	}
+	var existingEndpoints corev1.Endpoints
+	if err := c.localClient.Get(ctx, nsn, &existingEndpoints); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		if err := c.localClient.Create(ctx, wantEndpoints); err != nil {
+			return err
+		}
+	} else {
+		existingEndpoints.Subsets = wantEndpoints.Subsets
+		if err := c.localClient.Update(ctx, &existingEndpoints); err != nil {
+			return err
+		}
+	}
+
+	rc.mu.Lock()
+	rc.publishedNSNs[nsn] = struct{}{}
+	rc.mu.Unlock()
+	return nil
+}
+
+// unpublish removes the local Service/Endpoints published for a remote Elasticsearch cluster that
+// was deleted from the remote cluster.
+func (c *Controller) unpublish(ctx context.Context, rc *remoteCluster, es esv1.Elasticsearch) error {
+	nsn := types.NamespacedName{Namespace: es.Namespace, Name: services.RemoteTransportServiceName(es.Name)}
+	meta := metav1.ObjectMeta{Namespace: nsn.Namespace, Name: nsn.Name}
+
+	if err := c.localClient.Delete(ctx, &corev1.Service{ObjectMeta: meta}); err != nil && client.IgnoreNotFound(err) != nil {
+		return err
+	}
+	if err := c.localClient.Delete(ctx, &corev1.Endpoints{ObjectMeta: meta}); err != nil && client.IgnoreNotFound(err) != nil {
+		return err
+	}
+
+	rc.mu.Lock()
+	delete(rc.publishedNSNs, nsn)
+	rc.mu.Unlock()
+	return nil
+}
+
+// remoteEndpoints builds the Endpoints object pointing directly at the remote Elasticsearch pods'
+// routable addresses. A Service ClusterIP is a virtual, per-cluster address implemented by
+// iptables/ipvs rules local to that cluster: it is not reachable from another cluster even on a
+// flat pod network, so Endpoints must carry the pods' own IPs instead.
+func remoteEndpoints(ctx context.Context, remoteClient k8s.Client, es esv1.Elasticsearch) (*corev1.Endpoints, error) {
+	var pods corev1.PodList
+	if err := remoteClient.List(ctx, &pods, client.InNamespace(es.Namespace), client.MatchingLabels{
+		"elasticsearch.k8s.elastic.co/cluster-name": es.Name,
+	}); err != nil {
+		return nil, err
+	}
+
+	var addresses []corev1.EndpointAddress
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Status.PodIP == "" {
+			continue
+		}
+		addresses = append(addresses, corev1.EndpointAddress{
+			IP:        pod.Status.PodIP,
+			TargetRef: &corev1.ObjectReference{Kind: "Pod", Namespace: pod.Namespace, Name: pod.Name},
+		})
+	}
+
+	return &corev1.Endpoints{
+		ObjectMeta: services.RemoteTransportServiceObjectMeta(es),
+		Subsets: []corev1.EndpointSubset{
+			{
+				Addresses: addresses,
+				Ports: []corev1.EndpointPort{
+					{Name: "transport", Port: transportPort, Protocol: corev1.ProtocolTCP},
+				},
+			},
+		},
+	}, nil
+}
+
+// teardown removes the remote client and any Service/Endpoints it had published, when the
+// describing kubeconfig Secret is deleted.
+func (c *Controller) teardown(secretNSN types.NamespacedName) error {
+	rc, ok := c.remotes[secretNSN]
+	if !ok {
+		return nil
+	}
+	rc.stopWatch()
+	delete(c.remotes, secretNSN)
+
+	rc.mu.Lock()
+	published := make([]types.NamespacedName, 0, len(rc.publishedNSNs))
+	for nsn := range rc.publishedNSNs {
+		published = append(published, nsn)
+	}
+	rc.mu.Unlock()
+
+	for _, nsn := range published {
+		meta := metav1.ObjectMeta{Namespace: nsn.Namespace, Name: nsn.Name}
+		if err := c.localClient.Delete(context.Background(), &corev1.Service{ObjectMeta: meta}); err != nil && client.IgnoreNotFound(err) != nil {
+			return err
+		}
+		if err := c.localClient.Delete(context.Background(), &corev1.Endpoints{ObjectMeta: meta}); err != nil && client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
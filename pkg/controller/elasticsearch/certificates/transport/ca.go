@@ -6,6 +6,8 @@ package transport
 
 import (
 	"context"
+	"crypto/x509"
+	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -23,8 +25,16 @@ func CustomTransportCertsWatchKey(es types.NamespacedName) string {
 	return esv1.ESNamer.Suffix(es.Name, "custom-transport-certs")
 }
 
+// AdditionalCAConfigMapWatchKey returns the key used to identify the dynamic watch set on the
+// user-provided additional CA ConfigMap referenced by Spec.Transport.TLS.AdditionalCAConfigMapRef.
+func AdditionalCAConfigMapWatchKey(es types.NamespacedName) string {
+	return esv1.ESNamer.Suffix(es.Name, "additional-transport-ca")
+}
+
 // ReconcileOrRetrieveCA either reconciles a self-signed CA generated by the operator
-// or it retrieves a user defined CA certificate.
+// or it retrieves a user defined CA certificate. The returned trust bundle additionally
+// includes any extra CA certificates the user supplied through Spec.Transport.TLS.AdditionalCAConfigMapRef,
+// so that it can be written as-is into each node's keystore/truststore Secret.
 func ReconcileOrRetrieveCA(
 	ctx context.Context,
 	driver driver.Interface,
@@ -52,48 +62,120 @@ func ReconcileOrRetrieveCA(
 		driver.Recorder().Eventf(&es, corev1.EventTypeWarning, events.EventReasonUnexpected, err.Error())
 		return nil, err
 	}
+
+	var ca *certificates.CA
 	// 1. No custom certs are specified, reconcile our internal self-signed CA instead (probably the common case)
 	// or return the shared global CA
 	if customCASecret == nil {
 		if globalCA != nil {
-			return globalCA, nil
+			ca = globalCA
+		} else {
+			ca, err = certificates.ReconcileCAForOwner(
+				ctx,
+				driver.K8sClient(),
+				esv1.ESNamer,
+				&es,
+				labels,
+				certificates.TransportCAType,
+				rotationParams,
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		// 2. Assuming from here on the user wants to use custom certs and has configured a secret with them.
+
+		// Try to parse the provided secret to get to the CA and to report any validation errors to the user.
+		ca, err = certificates.ParseCustomCASecret(*customCASecret)
+		if err != nil {
+			// Surface validation/parsing errors to the user via an event otherwise they might be hard to spot
+			// validation at admission would also be an alternative but seems quite costly and secret contents might change
+			// in the time between admission and reading the secret contents so we need to re-run validation here anyway.
+			driver.Recorder().Eventf(&es, corev1.EventTypeWarning, events.EventReasonValidation, err.Error())
+			return nil, err
 		}
 
-		return certificates.ReconcileCAForOwner(
-			ctx,
-			driver.K8sClient(),
-			esv1.ESNamer,
-			&es,
-			labels,
-			certificates.TransportCAType,
-			rotationParams,
-		)
+		// Garbage collect the self-signed CA secret which might be left over from an earlier revision on a best effort basis.
+		err = driver.K8sClient().Delete(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      certificates.CAInternalSecretName(esv1.ESNamer, esNSN.Name, certificates.TransportCAType),
+				Namespace: esNSN.Namespace,
+			},
+		})
+		if err != nil && !apierrors.IsNotFound(err) {
+			log.Info("Failed to garbage collect self-signed transport CA secret, non-critical, continuing",
+				"namespace", esNSN.Namespace, "name", esNSN.Name, "err", err.Error(),
+			)
+		}
 	}
 
-	// 2. Assuming from here on the user wants to use custom certs and has configured a secret with them.
+	ca, err = reconcileAdditionalTrustedCAs(ctx, driver, es, ca)
+	if err != nil {
+		return nil, err
+	}
+
+	return ca, nil
+}
 
-	// Try to parse the provided secret to get to the CA and to report any validation errors to the user.
-	ca, err := certificates.ParseCustomCASecret(*customCASecret)
+// reconcileAdditionalTrustedCAs sets up a watch on the ConfigMap referenced by
+// Spec.Transport.TLS.AdditionalCAConfigMapRef (if any), validates its PEM-encoded CA certificates
+// and returns a copy of ca whose trust bundle is extended with them, so that ES nodes also trust
+// external CAs used by sidecars, cross-cluster remote clients, or mTLS-protected snapshot
+// repositories.
+func reconcileAdditionalTrustedCAs(ctx context.Context, driver driver.Interface, es esv1.Elasticsearch, ca *certificates.CA) (*certificates.CA, error) {
+	esNSN := k8s.ExtractNamespacedName(&es)
+	configMapRef := es.Spec.Transport.TLS.AdditionalCAConfigMapRef
+
+	// Always reconcile the watch, even when unset, so a previously set reference gets its watch removed.
+	if err := certificates.ReconcileCustomConfigMapWatch(
+		driver.DynamicWatches(),
+		AdditionalCAConfigMapWatchKey(esNSN),
+		esNSN,
+		configMapRef,
+	); err != nil {
+		return nil, err
+	}
+
+	if configMapRef == nil {
+		return ca, nil
+	}
+
+	var configMap corev1.ConfigMap
+	if err := driver.K8sClient().Get(ctx, types.NamespacedName{
+		Namespace: esNSN.Namespace,
+		Name:      configMapRef.Name,
+	}, &configMap); err != nil {
+		driver.Recorder().Eventf(&es, corev1.EventTypeWarning, events.EventReasonUnexpected, err.Error())
+		return nil, err
+	}
+
+	caWithExtraCAs, err := mergeExtraCAs(ca, configMap)
 	if err != nil {
-		// Surface validation/parsing errors to the user via an event otherwise they might be hard to spot
-		// validation at admission would also be an alternative but seems quite costly and secret contents might change
-		// in the time between admission and reading the secret contents so we need to re-run validation here anyway.
 		driver.Recorder().Eventf(&es, corev1.EventTypeWarning, events.EventReasonValidation, err.Error())
 		return nil, err
 	}
+	return caWithExtraCAs, nil
+}
 
-	// Garbage collect the self-signed CA secret which might be left over from an earlier revision on a best effort basis.
-	err = driver.K8sClient().Delete(ctx, &corev1.Secret{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      certificates.CAInternalSecretName(esv1.ESNamer, esNSN.Name, certificates.TransportCAType),
-			Namespace: esNSN.Namespace,
-		},
-	})
-	if err != nil && !apierrors.IsNotFound(err) {
-		log.Info("Failed to garbage collect self-signed transport CA secret, non-critical, continuing",
-			"namespace", esNSN.Namespace, "name", esNSN.Name, "err", err.Error(),
-		)
+// mergeExtraCAs parses the PEM-encoded CA certificates held in configMap and returns a copy of ca
+// whose ExtraCAs is replaced with them.
+//
+// A copy is returned rather than mutating ca in place: ca may be the shared globalCA, and mutating
+// it would leak one Elasticsearch resource's additional CAs into every other resource reusing that
+// same global CA. ExtraCAs is also replaced rather than appended to on every call, so repeated
+// reconciles of the same ConfigMap stay idempotent instead of growing the bundle without bound.
+func mergeExtraCAs(ca *certificates.CA, configMap corev1.ConfigMap) (*certificates.CA, error) {
+	var extraCAs []*x509.Certificate
+	for key, pemData := range configMap.Data {
+		parsed, err := certificates.ParsePEMCerts([]byte(pemData))
+		if err != nil {
+			return nil, fmt.Errorf("while parsing additional CA certificate %s/%s[%s]: %w", configMap.Namespace, configMap.Name, key, err)
+		}
+		extraCAs = append(extraCAs, parsed...)
 	}
 
-	return ca, nil
+	caWithExtraCAs := *ca
+	caWithExtraCAs.ExtraCAs = extraCAs
+	return &caWithExtraCAs, nil
 }
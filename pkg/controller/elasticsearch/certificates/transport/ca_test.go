@@ -0,0 +1,86 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License 2.0;
+// you may not use this file except in compliance with the Elastic License 2.0.
+
+package transport
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/elastic/cloud-on-k8s/v2/pkg/controller/common/certificates"
+)
+
+func selfSignedPEM(t *testing.T, commonName string) []byte {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("while generating key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("while creating certificate: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := pem.Encode(&buf, &pem.Block{Type: "CERTIFICATE", Bytes: der}); err != nil {
+		t.Fatalf("while encoding certificate: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestMergeExtraCAs_ReplacesRatherThanAppends(t *testing.T) {
+	firstRoundCA := selfSignedPEM(t, "first-round")
+	ca := &certificates.CA{}
+
+	merged, err := mergeExtraCAs(ca, corev1.ConfigMap{Data: map[string]string{"ca.crt": string(firstRoundCA)}})
+	if err != nil {
+		t.Fatalf("mergeExtraCAs() error = %v", err)
+	}
+	if len(merged.ExtraCAs) != 1 {
+		t.Fatalf("expected 1 ExtraCA after first merge, got %d", len(merged.ExtraCAs))
+	}
+
+	// A second reconcile of the same (unchanged) ConfigMap contents must not grow ExtraCAs: the
+	// bundle is replaced wholesale, not appended to.
+	merged, err = mergeExtraCAs(merged, corev1.ConfigMap{Data: map[string]string{"ca.crt": string(firstRoundCA)}})
+	if err != nil {
+		t.Fatalf("mergeExtraCAs() second call error = %v", err)
+	}
+	if len(merged.ExtraCAs) != 1 {
+		t.Fatalf("expected ExtraCAs to stay at 1 entry across reconciles, got %d", len(merged.ExtraCAs))
+	}
+}
+
+func TestMergeExtraCAs_DoesNotMutateSharedCA(t *testing.T) {
+	globalCA := &certificates.CA{}
+
+	merged, err := mergeExtraCAs(globalCA, corev1.ConfigMap{Data: map[string]string{"ca.crt": string(selfSignedPEM(t, "tenant-a"))}})
+	if err != nil {
+		t.Fatalf("mergeExtraCAs() error = %v", err)
+	}
+
+	if len(globalCA.ExtraCAs) != 0 {
+		t.Fatalf("expected the shared CA passed in to be left untouched, got %d ExtraCAs", len(globalCA.ExtraCAs))
+	}
+	if len(merged.ExtraCAs) != 1 {
+		t.Fatalf("expected the returned copy to carry the merged ExtraCAs, got %d", len(merged.ExtraCAs))
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License 2.0;
+// you may not use this file except in compliance with the Elastic License 2.0.
+
+// Package label builds the labels shared by every Kubernetes object (Pods, Services, ...)
+// belonging to an Elasticsearch cluster, so Services can select pods and GC code can list objects
+// by a single, consistent label set instead of each call site hand-rolling its own.
+package label
+
+import "k8s.io/apimachinery/pkg/types"
+
+// ClusterNameLabelName is the label identifying which Elasticsearch cluster an object belongs to.
+const ClusterNameLabelName = "elasticsearch.k8s.elastic.co/cluster-name"
+
+// RoleServiceLabelName marks a Service as one of the additional, node-role-scoped Services
+// generated from Spec.HTTP.Services, distinguishing it from the cluster's other Services (the
+// external Service, the internal Service, the discovery headless Service, ...) that also carry
+// ClusterNameLabelName. GC code must filter on this label, not ClusterNameLabelName alone, or it
+// will delete every other Service belonging to the cluster.
+const RoleServiceLabelName = "elasticsearch.k8s.elastic.co/role-service"
+
+// NodeTypesRoleLabelPrefix prefixes the per-node-role labels (e.g.
+// "node.role/coordinating": "true") set on pods, used to scope a role Service's selector down to
+// the subset of pods carrying a given role.
+const NodeTypesRoleLabelPrefix = "node.role/"
+
+// NewLabels returns the set of labels identifying all objects (Pods, Services, ...) that belong
+// to the Elasticsearch cluster nsn.
+func NewLabels(nsn types.NamespacedName) map[string]string {
+	return map[string]string{
+		ClusterNameLabelName: nsn.Name,
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License 2.0;
+// you may not use this file except in compliance with the Elastic License 2.0.
+
+package services
+
+import (
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	esv1 "github.com/elastic/cloud-on-k8s/v2/pkg/apis/elasticsearch/v1"
+	"github.com/elastic/cloud-on-k8s/v2/pkg/controller/elasticsearch/label"
+	"github.com/elastic/cloud-on-k8s/v2/pkg/utils/k8s"
+)
+
+const (
+	// AuthProxyContainerName is the name of the OAuth-proxy sidecar container injected into the
+	// Elasticsearch pod template when Spec.HTTP.Service.AuthProxy is enabled.
+	AuthProxyContainerName = "auth-proxy"
+
+	authProxyRBACSuffix = "auth-proxy"
+)
+
+// NewAuthProxyContainer returns the OAuth-proxy sidecar container that fronts the Elasticsearch
+// HTTP port, terminating TLS with certificates issued from the existing HTTP CA and authenticating
+// callers using their ServiceAccount token via the Kubernetes tokenreview/subjectaccessreview APIs.
+// It is injected into the StatefulSet pod template alongside the Elasticsearch container.
+// The second return value is false, with a zero Container, when auth proxy mode isn't enabled.
+func NewAuthProxyContainer(es esv1.Elasticsearch) (corev1.Container, bool) {
+	authProxy := es.Spec.HTTP.Service.AuthProxy
+	if authProxy == nil || !authProxy.Enabled {
+		return corev1.Container{}, false
+	}
+	return corev1.Container{
+		Name:  AuthProxyContainerName,
+		Image: authProxy.Image,
+		Args: []string{
+			"--https-address=:" + strconv.Itoa(AuthProxyPort),
+			"--upstream=https://localhost:" + strconv.Itoa(HTTPPort),
+			"--tls-cert=/mnt/elastic-internal/http-certs/tls.crt",
+			"--tls-key=/mnt/elastic-internal/http-certs/tls.key",
+			"--openshift-delegate-urls={\"/\":{\"resource\":\"elasticsearch\",\"verb\":\"get\"}}",
+			"--openshift-service-account=" + ExternalServiceName(es.Name),
+		},
+		Ports: []corev1.ContainerPort{
+			{Name: "auth-proxy-https", ContainerPort: int32(AuthProxyPort), Protocol: corev1.ProtocolTCP},
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: "elastic-internal-http-certificates", MountPath: "/mnt/elastic-internal/http-certs", ReadOnly: true},
+		},
+		ReadinessProbe: &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromInt(AuthProxyPort)},
+			},
+		},
+	}, true
+}
+
+// authProxyRBACName returns the name shared by the ClusterRole and ClusterRoleBinding that grant
+// the auth proxy sidecar permission to create TokenReviews and SubjectAccessReviews on behalf of
+// callers presenting a ServiceAccount token.
+func authProxyRBACName(esName string) string {
+	return ExternalServiceName(esName) + "-" + authProxyRBACSuffix
+}
+
+// NewAuthProxyClusterRole returns the ClusterRole granting the permissions the auth proxy sidecar
+// needs to validate bearer tokens: creating TokenReviews to authenticate the caller and
+// SubjectAccessReviews to authorize it against the Elasticsearch resource.
+func NewAuthProxyClusterRole(es esv1.Elasticsearch) rbacv1.ClusterRole {
+	return rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   authProxyRBACName(es.Name),
+			Labels: label.NewLabels(k8s.ExtractNamespacedName(&es)),
+		},
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{"authentication.k8s.io"},
+				Resources: []string{"tokenreviews"},
+				Verbs:     []string{"create"},
+			},
+			{
+				APIGroups: []string{"authorization.k8s.io"},
+				Resources: []string{"subjectaccessreviews"},
+				Verbs:     []string{"create"},
+			},
+		},
+	}
+}
+
+// NewAuthProxyClusterRoleBinding binds the auth proxy ClusterRole to the ServiceAccounts allowed
+// to authenticate against this cluster's auth-proxied external service.
+func NewAuthProxyClusterRoleBinding(es esv1.Elasticsearch, subjects []rbacv1.Subject) rbacv1.ClusterRoleBinding {
+	roleName := authProxyRBACName(es.Name)
+	return rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   roleName,
+			Labels: label.NewLabels(k8s.ExtractNamespacedName(&es)),
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     roleName,
+		},
+		Subjects: subjects,
+	}
+}
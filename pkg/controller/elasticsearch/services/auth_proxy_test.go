@@ -0,0 +1,44 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License 2.0;
+// you may not use this file except in compliance with the Elastic License 2.0.
+
+package services
+
+import (
+	"testing"
+
+	esv1 "github.com/elastic/cloud-on-k8s/v2/pkg/apis/elasticsearch/v1"
+)
+
+func TestNewAuthProxyContainer(t *testing.T) {
+	tests := []struct {
+		name      string
+		authProxy *esv1.AuthProxyConfig
+		wantOK    bool
+	}{
+		{name: "unset", authProxy: nil, wantOK: false},
+		{name: "disabled", authProxy: &esv1.AuthProxyConfig{Enabled: false}, wantOK: false},
+		{name: "enabled", authProxy: &esv1.AuthProxyConfig{Enabled: true, Image: "auth-proxy:1.0"}, wantOK: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			es := esv1.Elasticsearch{}
+			es.Name = "mycluster"
+			es.Spec.HTTP.Service.AuthProxy = tt.authProxy
+
+			container, ok := NewAuthProxyContainer(es)
+			if ok != tt.wantOK {
+				t.Fatalf("NewAuthProxyContainer() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !tt.wantOK {
+				if container.Image != "" {
+					t.Fatalf("expected a zero-value Container when auth proxy is not enabled, got %+v", container)
+				}
+				return
+			}
+			if container.Image != tt.authProxy.Image {
+				t.Fatalf("container.Image = %q, want %q", container.Image, tt.authProxy.Image)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License 2.0;
+// you may not use this file except in compliance with the Elastic License 2.0.
+
+package services
+
+import (
+	"context"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	esv1 "github.com/elastic/cloud-on-k8s/v2/pkg/apis/elasticsearch/v1"
+	"github.com/elastic/cloud-on-k8s/v2/pkg/controller/common"
+	"github.com/elastic/cloud-on-k8s/v2/pkg/controller/elasticsearch/label"
+	"github.com/elastic/cloud-on-k8s/v2/pkg/utils/k8s"
+	"github.com/elastic/cloud-on-k8s/v2/pkg/utils/stringsutil"
+)
+
+const (
+	globalServiceSuffix = ".svc.cluster.local"
+
+	// AuthProxyPort is the port the OAuth-proxy sidecar listens on when
+	// Spec.HTTP.Service.AuthProxy is enabled.
+	AuthProxyPort = 8443
+)
+
+// ExternalServiceURL returns the URL used to reach Elasticsearch's external endpoint.
+func ExternalServiceURL(es esv1.Elasticsearch) string {
+	return stringsutil.Concat("https://", ExternalServiceName(es.Name), ".", es.Namespace, globalServiceSuffix, ":", strconv.Itoa(externalServicePort(es)))
+}
+
+// externalServicePort returns the port the external service should expose: the OAuth-proxy
+// sidecar port when auth proxy mode is enabled, or the regular Elasticsearch HTTP port otherwise.
+func externalServicePort(es esv1.Elasticsearch) int {
+	if es.Spec.HTTP.Service.AuthProxy != nil && es.Spec.HTTP.Service.AuthProxy.Enabled {
+		return AuthProxyPort
+	}
+	return HTTPPort
+}
+
+// NewExternalService returns the external Service associated to the given cluster, used by users
+// to perform requests against one of the cluster nodes. When Spec.HTTP.Service.AuthProxy is
+// enabled, the Service fronts the OAuth-proxy sidecar injected into the StatefulSet pod template
+// instead of the Elasticsearch HTTP port directly, so that clients can authenticate using their
+// ServiceAccount token rather than ES credentials.
+func NewExternalService(es esv1.Elasticsearch) *corev1.Service {
+	nsn := k8s.ExtractNamespacedName(&es)
+	labels := make(map[string]string)
+	for k, v := range es.Spec.HTTP.Service.Metadata.Labels {
+		labels[k] = v
+	}
+	for k, v := range label.NewLabels(nsn) {
+		labels[k] = v
+	}
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   es.Namespace,
+			Name:        ExternalServiceName(es.Name),
+			Labels:      labels,
+			Annotations: es.Spec.HTTP.Service.Metadata.Annotations,
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: label.NewLabels(nsn),
+			Ports: []corev1.ServicePort{
+				{
+					Name:     "https",
+					Protocol: corev1.ProtocolTCP,
+					Port:     int32(externalServicePort(es)),
+				},
+			},
+			SessionAffinity: corev1.ServiceAffinityNone,
+			Type:            common.GetServiceType(es.Spec.HTTP.Service.Spec.Type),
+		},
+	}
+	if svc.Spec.Type != corev1.ServiceTypeClusterIP {
+		svc.Spec.ExternalTrafficPolicy = corev1.ServiceExternalTrafficPolicyTypeCluster
+	}
+	return svc
+}
+
+// IsServiceReady checks if a Service has one or more ready endpoints. When the auth proxy sidecar
+// is enabled, a pod only becomes a ready endpoint once both the Elasticsearch container and the
+// proxy container report ready, so no additional check is required here.
+func IsServiceReady(ctx context.Context, c k8s.Client, service corev1.Service) (bool, error) {
+	var endpoints corev1.Endpoints
+	nsn := types.NamespacedName{Namespace: service.Namespace, Name: service.Name}
+
+	if err := c.Get(ctx, nsn, &endpoints); err != nil {
+		return false, err
+	}
+	for _, subs := range endpoints.Subsets {
+		if len(subs.Addresses) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// GetExternalService returns the external Service associated to the given Elasticsearch cluster.
+func GetExternalService(ctx context.Context, c k8s.Client, es esv1.Elasticsearch) (corev1.Service, error) {
+	var svc corev1.Service
+	nsn := types.NamespacedName{Namespace: es.Namespace, Name: ExternalServiceName(es.Name)}
+	if err := c.Get(ctx, nsn, &svc); err != nil {
+		return corev1.Service{}, err
+	}
+	return svc, nil
+}
@@ -0,0 +1,55 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License 2.0;
+// you may not use this file except in compliance with the Elastic License 2.0.
+
+package services
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	esv1 "github.com/elastic/cloud-on-k8s/v2/pkg/apis/elasticsearch/v1"
+	"github.com/elastic/cloud-on-k8s/v2/pkg/controller/elasticsearch/label"
+	"github.com/elastic/cloud-on-k8s/v2/pkg/utils/k8s"
+)
+
+const (
+	internalServiceSuffix = "internal-http"
+
+	// HTTPPort is the Elasticsearch HTTP port internal-only clients connect to.
+	HTTPPort = 9200
+)
+
+// InternalServiceName returns the name of the cluster-local-only Service, e.g.
+// "<es>-es-internal-http". Unlike the ExternalService it is never exposed outside the cluster and
+// is backed by a certificate issued from a dedicated internal CA.
+func InternalServiceName(esName string) string {
+	return esv1.ESNamer.Suffix(esName, internalServiceSuffix)
+}
+
+// NewInternalService returns the ClusterIP-only Service used by operator-internal clients (e.g.
+// the reconciliation ES client) to reach Elasticsearch without going through the user-facing
+// ExternalService or its certificate chain.
+func NewInternalService(es esv1.Elasticsearch) *corev1.Service {
+	nsn := k8s.ExtractNamespacedName(&es)
+	labels := label.NewLabels(nsn)
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: es.Namespace,
+			Name:      InternalServiceName(es.Name),
+			Labels:    labels,
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: labels,
+			Ports: []corev1.ServicePort{
+				{
+					Name:     "https",
+					Protocol: corev1.ProtocolTCP,
+					Port:     HTTPPort,
+				},
+			},
+			Type:            corev1.ServiceTypeClusterIP,
+			SessionAffinity: corev1.ServiceAffinityNone,
+		},
+	}
+}
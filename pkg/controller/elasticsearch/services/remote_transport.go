@@ -0,0 +1,70 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License 2.0;
+// you may not use this file except in compliance with the Elastic License 2.0.
+
+// Package services builds the Kubernetes Services that front an Elasticsearch cluster, including
+// the ones published locally on behalf of clusters discovered in remote Kubernetes clusters.
+package services
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	esv1 "github.com/elastic/cloud-on-k8s/v2/pkg/apis/elasticsearch/v1"
+)
+
+const (
+	remoteTransportServiceSuffix = "remote-transport"
+
+	// TransportPort is the port remote-cluster Services forward traffic to on the remote side.
+	TransportPort = 9300
+)
+
+// ExternalServiceName returns the name of the Service used to reach the given Elasticsearch
+// cluster's HTTP endpoint.
+func ExternalServiceName(esName string) string {
+	return esv1.ESNamer.Suffix(esName, "http")
+}
+
+// RemoteTransportServiceName returns the name of the headless Service published locally for a
+// remote Elasticsearch cluster discovered via a remote-cluster kubeconfig Secret: the remote
+// cluster's own name, so that it resolves as "<remote-es>.<ns>.svc.cluster.local".
+func RemoteTransportServiceName(esName string) string {
+	return esName
+}
+
+// RemoteTransportServiceObjectMeta returns the ObjectMeta shared by the Service and Endpoints
+// published for a remote Elasticsearch cluster.
+func RemoteTransportServiceObjectMeta(es esv1.Elasticsearch) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Namespace: es.Namespace,
+		Name:      RemoteTransportServiceName(es.Name),
+		Labels: map[string]string{
+			"elasticsearch.k8s.elastic.co/remote-cluster-name": es.Name,
+		},
+	}
+}
+
+// NewRemoteTransportService returns the headless, selector-less Service published locally to
+// make a remote Elasticsearch cluster's transport port reachable by name, for cross-cluster
+// traffic that isn't backed by local pods. Its Endpoints are populated separately by the
+// remote-cluster controller, with the IPs of the remote Elasticsearch pods themselves.
+func NewRemoteTransportService(es esv1.Elasticsearch, remoteClusterName string) *corev1.Service {
+	meta := RemoteTransportServiceObjectMeta(es)
+	meta.Annotations = map[string]string{
+		"elasticsearch.k8s.elastic.co/remote-cluster-context": remoteClusterName,
+	}
+	return &corev1.Service{
+		ObjectMeta: meta,
+		Spec: corev1.ServiceSpec{
+			ClusterIP: corev1.ClusterIPNone,
+			Ports: []corev1.ServicePort{
+				{
+					Name:     "transport",
+					Protocol: corev1.ProtocolTCP,
+					Port:     TransportPort,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License 2.0;
+// you may not use this file except in compliance with the Elastic License 2.0.
+
+package services
+
+import (
+	"context"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	esv1 "github.com/elastic/cloud-on-k8s/v2/pkg/apis/elasticsearch/v1"
+	"github.com/elastic/cloud-on-k8s/v2/pkg/controller/common"
+	"github.com/elastic/cloud-on-k8s/v2/pkg/controller/elasticsearch/label"
+	"github.com/elastic/cloud-on-k8s/v2/pkg/utils/k8s"
+	"github.com/elastic/cloud-on-k8s/v2/pkg/utils/stringsutil"
+)
+
+// RoleServiceName returns the name of the additional Service created for the given node role,
+// e.g. "<esName>-es-http-coordinating".
+func RoleServiceName(esName string, role string) string {
+	return ExternalServiceName(esName) + "-" + role
+}
+
+// RoleServiceURL returns the URL used to reach the Service scoped to the given node role.
+func RoleServiceURL(es esv1.Elasticsearch, role string) string {
+	return stringsutil.Concat("https://", RoleServiceName(es.Name, role), ".", es.Namespace, globalServiceSuffix, ":", strconv.Itoa(externalServicePort(es)))
+}
+
+// NewRoleServices returns one Service per entry in Spec.HTTP.Services, each selecting only the
+// pods carrying the corresponding node.role label, so that bulk ingest or client traffic can be
+// routed to a subset of node pools without installing a separate ingress. Each Service is tagged
+// with label.RoleServiceLabelName so GarbageCollectRoleServices can list exactly these Services,
+// rather than every Service belonging to the cluster.
+func NewRoleServices(es esv1.Elasticsearch) []corev1.Service {
+	nsn := k8s.ExtractNamespacedName(&es)
+	baseSelector := label.NewLabels(nsn)
+
+	roleServiceLabels := make(map[string]string, len(baseSelector)+1)
+	for k, v := range baseSelector {
+		roleServiceLabels[k] = v
+	}
+	roleServiceLabels[label.RoleServiceLabelName] = "true"
+
+	services := make([]corev1.Service, 0, len(es.Spec.HTTP.Services))
+	for _, namedSvc := range es.Spec.HTTP.Services {
+		selector := make(map[string]string, len(baseSelector)+1)
+		for k, v := range baseSelector {
+			selector[k] = v
+		}
+		selector[label.NodeTypesRoleLabelPrefix+namedSvc.Role] = "true"
+
+		labels := make(map[string]string, len(namedSvc.Metadata.Labels)+len(roleServiceLabels))
+		for k, v := range namedSvc.Metadata.Labels {
+			labels[k] = v
+		}
+		for k, v := range roleServiceLabels {
+			labels[k] = v
+		}
+
+		services = append(services, corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   es.Namespace,
+				Name:        RoleServiceName(es.Name, namedSvc.Role),
+				Labels:      labels,
+				Annotations: namedSvc.Metadata.Annotations,
+			},
+			Spec: corev1.ServiceSpec{
+				Selector: selector,
+				Ports: []corev1.ServicePort{
+					{
+						Name:     "https",
+						Protocol: corev1.ProtocolTCP,
+						Port:     int32(externalServicePort(es)),
+					},
+				},
+				SessionAffinity: corev1.ServiceAffinityNone,
+				Type:            common.GetServiceType(namedSvc.Spec.Type),
+			},
+		})
+	}
+	return services
+}
+
+// RoleServiceSANs returns the DNS names that should be added as certificate SAN entries so that
+// certificates served on the node-role-scoped Services validate against their own names, in
+// addition to the main ExternalService name. Both the short in-namespace name and the fully
+// qualified name are included, since clients may connect using either form - RoleServiceURL itself
+// connects using the FQDN.
+func RoleServiceSANs(es esv1.Elasticsearch) []string {
+	sans := make([]string, 0, len(es.Spec.HTTP.Services)*2)
+	for _, namedSvc := range es.Spec.HTTP.Services {
+		svcName := RoleServiceName(es.Name, namedSvc.Role)
+		sans = append(sans, svcName, stringsutil.Concat(svcName, ".", es.Namespace, globalServiceSuffix))
+	}
+	return sans
+}
+
+// GarbageCollectRoleServices deletes role-scoped Services whose role no longer appears in
+// Spec.HTTP.Services, e.g. because the user removed a node role from the cluster topology. It
+// lists on label.RoleServiceLabelName in addition to the cluster-membership label, so it only ever
+// considers the role Services it created, not every Service belonging to the cluster (the
+// discovery headless Service, the internal Service, ...).
+func GarbageCollectRoleServices(ctx context.Context, c k8s.Client, es esv1.Elasticsearch) error {
+	wanted := make(map[string]struct{}, len(es.Spec.HTTP.Services))
+	for _, namedSvc := range es.Spec.HTTP.Services {
+		wanted[RoleServiceName(es.Name, namedSvc.Role)] = struct{}{}
+	}
+
+	nsn := k8s.ExtractNamespacedName(&es)
+	roleServiceSelector := label.NewLabels(nsn)
+	roleServiceSelector[label.RoleServiceLabelName] = "true"
+
+	var existing corev1.ServiceList
+	if err := c.List(ctx, &existing, client.InNamespace(es.Namespace), client.MatchingLabels(roleServiceSelector)); err != nil {
+		return err
+	}
+
+	for i := range existing.Items {
+		svc := existing.Items[i]
+		if _, stillWanted := wanted[svc.Name]; stillWanted {
+			continue
+		}
+		if err := c.Delete(ctx, &svc); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
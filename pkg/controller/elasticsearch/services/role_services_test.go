@@ -0,0 +1,70 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License 2.0;
+// you may not use this file except in compliance with the Elastic License 2.0.
+
+package services
+
+import (
+	"testing"
+
+	esv1 "github.com/elastic/cloud-on-k8s/v2/pkg/apis/elasticsearch/v1"
+	"github.com/elastic/cloud-on-k8s/v2/pkg/controller/elasticsearch/label"
+)
+
+func testElasticsearchWithRoles(roles ...string) esv1.Elasticsearch {
+	es := esv1.Elasticsearch{}
+	es.Name = "mycluster"
+	es.Namespace = "ns"
+	for _, role := range roles {
+		es.Spec.HTTP.Services = append(es.Spec.HTTP.Services, esv1.NamedServiceSpec{Role: role})
+	}
+	return es
+}
+
+func TestNewRoleServices_TagsWithRoleServiceLabel(t *testing.T) {
+	es := testElasticsearchWithRoles("coordinating", "ingest")
+
+	services := NewRoleServices(es)
+	if len(services) != 2 {
+		t.Fatalf("expected 2 Services, got %d", len(services))
+	}
+	for _, svc := range services {
+		if svc.Labels[label.RoleServiceLabelName] != "true" {
+			t.Errorf("Service %s missing %s label, required for GarbageCollectRoleServices to scope its List to role Services only", svc.Name, label.RoleServiceLabelName)
+		}
+		if svc.Labels[label.ClusterNameLabelName] != es.Name {
+			t.Errorf("Service %s missing cluster-name label %s", svc.Name, label.ClusterNameLabelName)
+		}
+	}
+}
+
+func TestNewRoleServices_NamesAndSelectors(t *testing.T) {
+	es := testElasticsearchWithRoles("ml")
+	services := NewRoleServices(es)
+
+	if got, want := services[0].Name, RoleServiceName(es.Name, "ml"); got != want {
+		t.Errorf("Name = %q, want %q", got, want)
+	}
+	if got := services[0].Spec.Selector[label.NodeTypesRoleLabelPrefix+"ml"]; got != "true" {
+		t.Errorf("expected selector to be scoped to the ml role, got %+v", services[0].Spec.Selector)
+	}
+}
+
+func TestRoleServiceSANs_IncludesShortNameAndFQDN(t *testing.T) {
+	es := testElasticsearchWithRoles("coordinating")
+	sans := RoleServiceSANs(es)
+
+	shortName := RoleServiceName(es.Name, "coordinating")
+	fqdn := shortName + "." + es.Namespace + globalServiceSuffix
+
+	found := map[string]bool{}
+	for _, san := range sans {
+		found[san] = true
+	}
+	if !found[shortName] {
+		t.Errorf("expected SANs to include the short name %q, got %v", shortName, sans)
+	}
+	if !found[fqdn] {
+		t.Errorf("expected SANs to include the FQDN %q (the form used by RoleServiceURL), got %v", fqdn, sans)
+	}
+}